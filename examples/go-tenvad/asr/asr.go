@@ -0,0 +1,45 @@
+//
+//  Copyright © 2025 Agora
+//  This file is part of TEN Framework, an open source project.
+//  Licensed under the Apache License, Version 2.0, with certain conditions.
+//  Refer to the "LICENSE" file in the root directory for more information.
+//
+
+// Package asr couples tenvad's VadSession speech-segment detector to
+// pluggable streaming ASR backends. A Pipeline only forwards audio to a
+// Sink between SpeechStart and SpeechEnd events, which cuts the bandwidth
+// and false transcriptions an ASR backend would otherwise see during
+// silence, modeled on the Google Speech StreamingRecognize pattern.
+package asr
+
+import "context"
+
+// Result is a transcription returned from a Sink's Flush.
+type Result struct {
+	// Text is the transcribed text.
+	Text string
+	// IsFinal is false for an intermediate flush triggered mid-utterance
+	// (see PipelineConfig.PartialFlushInterval) and true once the
+	// utterance's SpeechEnd has closed it out.
+	IsFinal bool
+}
+
+// Sink is a streaming ASR backend. Its methods are called in the sequence
+// Start, Write (zero or more times), Flush, with Close only once the Sink
+// is no longer needed.
+type Sink interface {
+	// Start begins a new utterance at the given sample rate.
+	Start(ctx context.Context, sampleRate int) error
+	// Write forwards a chunk of int16 PCM audio belonging to the
+	// in-progress utterance.
+	Write(pcm []int16) error
+	// Flush asks the backend for its current transcription of the
+	// utterance so far. final is true when the utterance's SpeechEnd has
+	// closed it out, in which case the Sink must also reset itself for the
+	// next utterance; final is false for an intermediate flush (see
+	// PipelineConfig.PartialFlushInterval), after which Write is called
+	// again for the same utterance.
+	Flush(final bool) (Result, error)
+	// Close releases any resources held by the Sink.
+	Close() error
+}
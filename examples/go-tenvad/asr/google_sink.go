@@ -0,0 +1,165 @@
+//
+//  Copyright © 2025 Agora
+//  This file is part of TEN Framework, an open source project.
+//  Licensed under the Apache License, Version 2.0, with certain conditions.
+//  Refer to the "LICENSE" file in the root directory for more information.
+//
+//go:build gcp
+
+package asr
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+
+	speech "cloud.google.com/go/speech/apiv1"
+	"cloud.google.com/go/speech/apiv1/speechpb"
+)
+
+// GoogleSink streams audio to the Google Cloud Speech-to-Text
+// StreamingRecognize API. Build with -tags gcp to pull in this backend and
+// its dependency on cloud.google.com/go/speech.
+//
+// GoogleSink expects Application Default Credentials to be configured in
+// the environment (see cloud.google.com/go docs); it does not accept
+// credentials directly.
+//
+// Start requests interim results from the API and keeps the stream open
+// across a non-final Flush (see PipelineConfig.PartialFlushInterval), so
+// that Write can keep feeding the same utterance afterward; only a final
+// Flush closes the send side and tears the stream down.
+type GoogleSink struct {
+	client       *speech.Client
+	languageCode string
+
+	stream   speechpb.Speech_StreamingRecognizeClient
+	cancel   context.CancelFunc
+	recvDone chan struct{}
+
+	mu      sync.Mutex
+	latest  string
+	recvErr error
+}
+
+// NewGoogleSink creates a GoogleSink that transcribes audio as
+// languageCode (e.g. "en-US").
+func NewGoogleSink(ctx context.Context, languageCode string) (*GoogleSink, error) {
+	client, err := speech.NewClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("asr: could not create Speech client: %w", err)
+	}
+	return &GoogleSink{client: client, languageCode: languageCode}, nil
+}
+
+func (s *GoogleSink) Start(ctx context.Context, sampleRate int) error {
+	streamCtx, cancel := context.WithCancel(ctx)
+	stream, err := s.client.StreamingRecognize(streamCtx)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("asr: could not start StreamingRecognize: %w", err)
+	}
+
+	err = stream.Send(&speechpb.StreamingRecognizeRequest{
+		StreamingRequest: &speechpb.StreamingRecognizeRequest_StreamingConfig{
+			StreamingConfig: &speechpb.StreamingRecognitionConfig{
+				Config: &speechpb.RecognitionConfig{
+					Encoding:        speechpb.RecognitionConfig_LINEAR16,
+					SampleRateHertz: int32(sampleRate),
+					LanguageCode:    s.languageCode,
+				},
+				InterimResults: true,
+			},
+		},
+	})
+	if err != nil {
+		cancel()
+		return fmt.Errorf("asr: could not send streaming config: %w", err)
+	}
+
+	s.stream = stream
+	s.cancel = cancel
+	s.latest = ""
+	s.recvErr = nil
+	s.recvDone = make(chan struct{})
+	go s.recvLoop(stream, s.recvDone)
+	return nil
+}
+
+// recvLoop keeps reading interim and final results off stream for as long
+// as it stays open, so that a non-final Flush can report the latest
+// transcript without closing the send side.
+func (s *GoogleSink) recvLoop(stream speechpb.Speech_StreamingRecognizeClient, done chan struct{}) {
+	defer close(done)
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if err != io.EOF {
+				s.mu.Lock()
+				s.recvErr = fmt.Errorf("asr: streaming recv failed: %w", err)
+				s.mu.Unlock()
+			}
+			return
+		}
+		for _, res := range resp.GetResults() {
+			if len(res.GetAlternatives()) > 0 {
+				s.mu.Lock()
+				s.latest = res.GetAlternatives()[0].GetTranscript()
+				s.mu.Unlock()
+			}
+		}
+	}
+}
+
+func (s *GoogleSink) Write(pcm []int16) error {
+	if s.stream == nil {
+		return fmt.Errorf("asr: GoogleSink.Write called before Start")
+	}
+	raw := make([]byte, len(pcm)*2)
+	for i, v := range pcm {
+		binary.LittleEndian.PutUint16(raw[i*2:], uint16(v))
+	}
+	return s.stream.Send(&speechpb.StreamingRecognizeRequest{
+		StreamingRequest: &speechpb.StreamingRecognizeRequest_AudioContent{
+			AudioContent: raw,
+		},
+	})
+}
+
+func (s *GoogleSink) Flush(final bool) (Result, error) {
+	if s.stream == nil {
+		return Result{}, fmt.Errorf("asr: GoogleSink.Flush called before Start")
+	}
+
+	if !final {
+		s.mu.Lock()
+		text, err := s.latest, s.recvErr
+		s.mu.Unlock()
+		if err != nil {
+			return Result{}, err
+		}
+		return Result{Text: text}, nil
+	}
+
+	if err := s.stream.CloseSend(); err != nil {
+		return Result{}, fmt.Errorf("asr: could not close send stream: %w", err)
+	}
+	<-s.recvDone
+
+	s.mu.Lock()
+	text, err := s.latest, s.recvErr
+	s.mu.Unlock()
+
+	s.cancel()
+	s.stream = nil
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{Text: text}, nil
+}
+
+func (s *GoogleSink) Close() error {
+	return s.client.Close()
+}
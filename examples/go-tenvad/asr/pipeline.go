@@ -0,0 +1,131 @@
+//
+//  Copyright © 2025 Agora
+//  This file is part of TEN Framework, an open source project.
+//  Licensed under the Apache License, Version 2.0, with certain conditions.
+//  Refer to the "LICENSE" file in the root directory for more information.
+//
+package asr
+
+import (
+	"context"
+	"time"
+
+	"tenvad"
+)
+
+// PipelineConfig configures a Pipeline.
+type PipelineConfig struct {
+	// Session detects speech segments in the incoming audio. The Pipeline
+	// does not take ownership of Session's underlying Vad instance; the
+	// caller is still responsible for closing it.
+	Session *tenvad.VadSession
+	// Sink receives audio only while a speech segment is open.
+	Sink Sink
+	// SampleRate is the sample rate of the audio passed to ProcessChunk,
+	// and must match Session's configured rate.
+	SampleRate int
+	// PartialFlushInterval, if non-zero, triggers an intermediate
+	// Sink.Flush after this much continuous speech, so callers can
+	// surface partial hypotheses on long utterances without waiting for
+	// SpeechEnd.
+	PartialFlushInterval time.Duration
+}
+
+// Pipeline consumes int16 audio, runs VAD over it, and forwards audio to a
+// Sink only between SpeechStart and SpeechEnd. Because VadSession reports
+// transitions at hop granularity, a chunk straddling a transition is
+// forwarded or withheld in its entirety rather than split at the exact
+// sample boundary; this is the tradeoff for not having to re-derive the
+// Vad's own per-frame state here.
+type Pipeline struct {
+	cfg                   PipelineConfig
+	speaking              bool
+	samplesSinceFlush     int
+	partialFlushInSamples int
+}
+
+// NewPipeline creates a Pipeline from cfg.
+func NewPipeline(cfg PipelineConfig) *Pipeline {
+	p := &Pipeline{cfg: cfg}
+	if cfg.PartialFlushInterval > 0 && cfg.SampleRate > 0 {
+		p.partialFlushInSamples = int(cfg.PartialFlushInterval.Seconds() * float64(cfg.SampleRate))
+	}
+	return p
+}
+
+// ProcessChunk feeds samples through the VAD and, if a speech segment is
+// open, forwards them to the Sink. It returns any Results produced by a
+// Sink.Flush triggered during this call (by SpeechEnd or by
+// PartialFlushInterval).
+func (p *Pipeline) ProcessChunk(ctx context.Context, samples []int16) ([]Result, error) {
+	transitions, err := p.cfg.Session.ProcessChunk(samples)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []Result
+	// wroteChunk tracks whether samples has already been handed to some
+	// Sink during this call, so that a SpeechStart and SpeechEnd landing in
+	// the same chunk (any chunk long enough to cover a full short
+	// utterance) still has its audio written before the Sink is flushed,
+	// instead of only being considered at the end of the loop. It is
+	// deliberately NOT reset on a later SpeechStart in the same call: this
+	// raw sample range can only be attributed to one Sink lifecycle, and
+	// having already forwarded it to the segment that just ended, it must
+	// not also be forwarded to the next one that opens in the same chunk.
+	wroteChunk := false
+
+	for _, t := range transitions {
+		switch t.(type) {
+		case tenvad.SpeechStart:
+			if err := p.cfg.Sink.Start(ctx, p.cfg.SampleRate); err != nil {
+				return results, err
+			}
+			p.speaking = true
+			p.samplesSinceFlush = 0
+		case tenvad.SpeechEnd:
+			if p.speaking {
+				if !wroteChunk {
+					if err := p.cfg.Sink.Write(samples); err != nil {
+						return results, err
+					}
+					wroteChunk = true
+				}
+				res, err := p.cfg.Sink.Flush(true)
+				if err != nil {
+					return results, err
+				}
+				res.IsFinal = true
+				results = append(results, res)
+				p.speaking = false
+			}
+		}
+	}
+
+	if p.speaking && !wroteChunk {
+		if err := p.cfg.Sink.Write(samples); err != nil {
+			return results, err
+		}
+		wroteChunk = true
+	}
+
+	if p.speaking {
+		p.samplesSinceFlush += len(samples)
+
+		if p.partialFlushInSamples > 0 && p.samplesSinceFlush >= p.partialFlushInSamples {
+			res, err := p.cfg.Sink.Flush(false)
+			if err != nil {
+				return results, err
+			}
+			results = append(results, res)
+			p.samplesSinceFlush = 0
+		}
+	}
+
+	return results, nil
+}
+
+// Close releases the Sink.
+func (p *Pipeline) Close() error {
+	return p.cfg.Sink.Close()
+}
@@ -0,0 +1,91 @@
+//
+//  Copyright © 2025 Agora
+//  This file is part of TEN Framework, an open source project.
+//  Licensed under the Apache License, Version 2.0, with certain conditions.
+//  Refer to the "LICENSE" file in the root directory for more information.
+//
+package asr
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gorilla/websocket"
+)
+
+// controlMessage is a JSON control frame sent alongside length-prefixed PCM
+// binary frames, so a server can tell utterance boundaries apart from raw
+// audio on the same connection.
+type controlMessage struct {
+	Event string `json:"event"`
+}
+
+// WebSocketSink is a generic Sink that streams PCM audio to a server over
+// a WebSocket connection: each Write is sent as a binary frame containing
+// a 4-byte little-endian sample count followed by the raw int16 samples,
+// and Start/Flush send `{"event":"speech_start"}` / `{"event":"speech_end"}`
+// / `{"event":"partial"}` JSON control frames, the last of these for a
+// non-final Flush. Flush's Result.Text is read from the next text frame
+// the server sends back; the connection stays open after a non-final
+// Flush so Write can keep streaming the same utterance.
+type WebSocketSink struct {
+	url  string
+	conn *websocket.Conn
+}
+
+// NewWebSocketSink creates a WebSocketSink that dials url on Start.
+func NewWebSocketSink(url string) *WebSocketSink {
+	return &WebSocketSink{url: url}
+}
+
+func (s *WebSocketSink) Start(ctx context.Context, sampleRate int) error {
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, s.url, nil)
+	if err != nil {
+		return fmt.Errorf("asr: could not dial %q: %w", s.url, err)
+	}
+	s.conn = conn
+	return s.conn.WriteJSON(controlMessage{Event: "speech_start"})
+}
+
+func (s *WebSocketSink) Write(pcm []int16) error {
+	if s.conn == nil {
+		return fmt.Errorf("asr: WebSocketSink.Write called before Start")
+	}
+	frame := make([]byte, 4+len(pcm)*2)
+	binary.LittleEndian.PutUint32(frame, uint32(len(pcm)))
+	for i, v := range pcm {
+		binary.LittleEndian.PutUint16(frame[4+i*2:], uint16(v))
+	}
+	return s.conn.WriteMessage(websocket.BinaryMessage, frame)
+}
+
+func (s *WebSocketSink) Flush(final bool) (Result, error) {
+	if s.conn == nil {
+		return Result{}, fmt.Errorf("asr: WebSocketSink.Flush called before Start")
+	}
+	event := "partial"
+	if final {
+		event = "speech_end"
+	}
+	if err := s.conn.WriteJSON(controlMessage{Event: event}); err != nil {
+		return Result{}, err
+	}
+	_, text, err := s.conn.ReadMessage()
+	if err != nil {
+		return Result{}, fmt.Errorf("asr: could not read transcription: %w", err)
+	}
+	var result Result
+	if err := json.Unmarshal(text, &result); err != nil {
+		return Result{}, fmt.Errorf("asr: could not decode transcription: %w", err)
+	}
+	return result, nil
+}
+
+func (s *WebSocketSink) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+	return s.conn.Close()
+}
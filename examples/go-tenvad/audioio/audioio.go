@@ -0,0 +1,65 @@
+//
+//  Copyright © 2025 Agora
+//  This file is part of TEN Framework, an open source project.
+//  Licensed under the Apache License, Version 2.0, with certain conditions.
+//  Refer to the "LICENSE" file in the root directory for more information.
+//
+
+// Package audioio decodes audio files into the int16 PCM samples expected
+// by tenvad.Vad. Support for each container/codec lives behind its own
+// build tag (disable_flac, disable_mp3, disable_opus) so a minimal,
+// CGO-free build can still decode WAV, while a full build picks up the
+// rest automatically.
+package audioio
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Decoder decodes one audio container/codec into raw PCM samples, the
+// sample rate the samples were encoded at, and the channel count.
+type Decoder interface {
+	Decode(r io.ReadSeeker) (samples []int16, sampleRate int, channels int, err error)
+}
+
+// supportedRates are the sample rates tenvad.Vad accepts.
+var supportedRates = []int{8000, 16000, 32000, 48000}
+
+var decoders = map[string]Decoder{}
+
+// register associates a Decoder with a file extension (including the
+// leading dot, e.g. ".wav"). Format packages call this from an init().
+func register(ext string, d Decoder) {
+	decoders[ext] = d
+}
+
+// DecodeFile decodes the audio file at path, dispatching on its extension,
+// then downmixes it to mono and resamples it to the nearest sample rate
+// tenvad.Vad supports (8/16/32/48 kHz) so the result can be fed directly
+// to Vad.Process.
+func DecodeFile(path string) (samples []int16, sampleRate int, err error) {
+	ext := strings.ToLower(filepath.Ext(path))
+	d, ok := decoders[ext]
+	if !ok {
+		return nil, 0, fmt.Errorf("audioio: no decoder registered for extension %q", ext)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, 0, fmt.Errorf("audioio: could not open %q: %w", path, err)
+	}
+	defer f.Close()
+
+	samples, rate, channels, err := d.Decode(f)
+	if err != nil {
+		return nil, 0, fmt.Errorf("audioio: could not decode %q: %w", path, err)
+	}
+
+	samples = downmixToMono(samples, channels)
+	samples, rate = resampleToSupportedRate(samples, rate)
+	return samples, rate, nil
+}
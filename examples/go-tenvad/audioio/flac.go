@@ -0,0 +1,59 @@
+//
+//  Copyright © 2025 Agora
+//  This file is part of TEN Framework, an open source project.
+//  Licensed under the Apache License, Version 2.0, with certain conditions.
+//  Refer to the "LICENSE" file in the root directory for more information.
+//
+//go:build !disable_flac
+
+package audioio
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mewkiz/flac"
+)
+
+func init() {
+	register(".flac", flacDecoder{})
+}
+
+// flacDecoder decodes FLAC streams via github.com/mewkiz/flac. Build with
+// -tags disable_flac to drop this decoder and its dependency.
+type flacDecoder struct{}
+
+func (flacDecoder) Decode(r io.ReadSeeker) ([]int16, int, int, error) {
+	stream, err := flac.NewSeek(r)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("audioio: could not open FLAC stream: %w", err)
+	}
+	defer stream.Close()
+
+	channels := int(stream.Info.NChannels)
+	shift := int(stream.Info.BitsPerSample) - 16
+
+	var samples []int16
+	for {
+		frame, err := stream.ParseNext()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, 0, 0, fmt.Errorf("audioio: could not decode FLAC frame: %w", err)
+		}
+		for i := 0; i < frame.Subframes[0].NSamples; i++ {
+			for c := 0; c < channels; c++ {
+				v := frame.Subframes[c].Samples[i]
+				switch {
+				case shift > 0:
+					v >>= uint(shift)
+				case shift < 0:
+					v <<= uint(-shift)
+				}
+				samples = append(samples, int16(v))
+			}
+		}
+	}
+	return samples, int(stream.Info.SampleRate), channels, nil
+}
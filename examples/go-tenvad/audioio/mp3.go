@@ -0,0 +1,44 @@
+//
+//  Copyright © 2025 Agora
+//  This file is part of TEN Framework, an open source project.
+//  Licensed under the Apache License, Version 2.0, with certain conditions.
+//  Refer to the "LICENSE" file in the root directory for more information.
+//
+//go:build !disable_mp3
+
+package audioio
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+func init() {
+	register(".mp3", mp3Decoder{})
+}
+
+// mp3Decoder decodes MP3 files via github.com/hajimehoshi/go-mp3, which
+// always yields 16-bit stereo PCM. Build with -tags disable_mp3 to drop
+// this decoder and its dependency.
+type mp3Decoder struct{}
+
+func (mp3Decoder) Decode(r io.ReadSeeker) ([]int16, int, int, error) {
+	d, err := mp3.NewDecoder(r)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("audioio: could not open MP3 stream: %w", err)
+	}
+
+	raw, err := io.ReadAll(d)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("audioio: could not decode MP3 data: %w", err)
+	}
+
+	samples := make([]int16, len(raw)/2)
+	for i := range samples {
+		samples[i] = int16(binary.LittleEndian.Uint16(raw[i*2:]))
+	}
+	return samples, d.SampleRate(), 2, nil
+}
@@ -0,0 +1,55 @@
+//
+//  Copyright © 2025 Agora
+//  This file is part of TEN Framework, an open source project.
+//  Licensed under the Apache License, Version 2.0, with certain conditions.
+//  Refer to the "LICENSE" file in the root directory for more information.
+//
+//go:build !disable_opus
+
+package audioio
+
+import (
+	"fmt"
+	"io"
+
+	"gopkg.in/hraban/opus.v2"
+)
+
+func init() {
+	register(".opus", opusDecoder{})
+}
+
+// opusDecoder decodes Ogg Opus files via gopkg.in/hraban/opus.v2, which
+// wraps libopusfile. libopusfile always decodes to 48 kHz stereo PCM
+// regardless of the source encoding. Build with -tags disable_opus to
+// drop this decoder and its (CGO) dependency on libopus/libopusfile.
+type opusDecoder struct{}
+
+const (
+	opusSampleRate = 48000
+	opusChannels   = 2
+)
+
+func (opusDecoder) Decode(r io.ReadSeeker) ([]int16, int, int, error) {
+	stream, err := opus.NewStream(r)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("audioio: could not open Opus stream: %w", err)
+	}
+	defer stream.Close()
+
+	var samples []int16
+	buf := make([]int16, 4096*opusChannels)
+	for {
+		n, err := stream.Read(buf)
+		if n > 0 {
+			samples = append(samples, buf[:n*opusChannels]...)
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, 0, 0, fmt.Errorf("audioio: could not decode Opus data: %w", err)
+		}
+	}
+	return samples, opusSampleRate, opusChannels, nil
+}
@@ -0,0 +1,65 @@
+//
+//  Copyright © 2025 Agora
+//  This file is part of TEN Framework, an open source project.
+//  Licensed under the Apache License, Version 2.0, with certain conditions.
+//  Refer to the "LICENSE" file in the root directory for more information.
+//
+package audioio
+
+// downmixToMono averages interleaved channels down to a single channel.
+// Samples with channels <= 1 are returned unchanged.
+func downmixToMono(samples []int16, channels int) []int16 {
+	if channels <= 1 {
+		return samples
+	}
+	out := make([]int16, len(samples)/channels)
+	for i := range out {
+		var sum int32
+		for c := 0; c < channels; c++ {
+			sum += int32(samples[i*channels+c])
+		}
+		out[i] = int16(sum / int32(channels))
+	}
+	return out
+}
+
+// nearestSupportedRate returns the entry in supportedRates closest to rate.
+func nearestSupportedRate(rate int) int {
+	best := supportedRates[0]
+	for _, r := range supportedRates {
+		if abs(rate-r) < abs(rate-best) {
+			best = r
+		}
+	}
+	return best
+}
+
+// resampleToSupportedRate linearly resamples samples (recorded at
+// sampleRate) to the nearest rate tenvad.Vad supports.
+func resampleToSupportedRate(samples []int16, sampleRate int) ([]int16, int) {
+	target := nearestSupportedRate(sampleRate)
+	if target == sampleRate || len(samples) == 0 {
+		return samples, sampleRate
+	}
+
+	outLen := int(int64(len(samples)) * int64(target) / int64(sampleRate))
+	out := make([]int16, outLen)
+	for i := range out {
+		srcPos := float64(i) * float64(sampleRate) / float64(target)
+		i0 := int(srcPos)
+		if i0 >= len(samples)-1 {
+			out[i] = samples[len(samples)-1]
+			continue
+		}
+		frac := srcPos - float64(i0)
+		out[i] = int16(float64(samples[i0])*(1-frac) + float64(samples[i0+1])*frac)
+	}
+	return out, target
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
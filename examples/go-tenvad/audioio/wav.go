@@ -0,0 +1,46 @@
+//
+//  Copyright © 2025 Agora
+//  This file is part of TEN Framework, an open source project.
+//  Licensed under the Apache License, Version 2.0, with certain conditions.
+//  Refer to the "LICENSE" file in the root directory for more information.
+//
+package audioio
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-audio/wav"
+)
+
+func init() {
+	register(".wav", wavDecoder{})
+}
+
+// wavDecoder decodes PCM WAV files via github.com/go-audio/wav. It is
+// always built in, regardless of the disable_* build tags, since tenvad's
+// demos have depended on it from the start.
+type wavDecoder struct{}
+
+func (wavDecoder) Decode(r io.ReadSeeker) ([]int16, int, int, error) {
+	d := wav.NewDecoder(r)
+	if !d.IsValidFile() {
+		return nil, 0, 0, fmt.Errorf("audioio: not a valid WAV file")
+	}
+
+	buf, err := d.FullPCMBuffer()
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("audioio: could not read WAV PCM data: %w", err)
+	}
+
+	shift := int(d.BitDepth) - 16
+	if shift < 0 {
+		return nil, 0, 0, fmt.Errorf("audioio: unsupported WAV bit depth %d (need >= 16)", d.BitDepth)
+	}
+
+	samples := make([]int16, len(buf.Data))
+	for i, v := range buf.Data {
+		samples[i] = int16(v >> uint(shift))
+	}
+	return samples, int(d.SampleRate), int(d.NumChans), nil
+}
@@ -0,0 +1,178 @@
+//
+//  Copyright © 2025 Agora
+//  This file is part of TEN Framework, an open source project.
+//  Licensed under the Apache License, Version 2.0, with certain conditions.
+//  Refer to the "LICENSE" file in the root directory for more information.
+//
+
+// Package batch runs tenvad.Vad over many audio files in parallel, using
+// a pool of workers that each own their own Vad instance (a C handle is
+// stateful and cannot be shared across goroutines). It is built for
+// offline, dataset-scale labeling rather than live streams.
+package batch
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	"tenvad"
+	"tenvad/audioio"
+)
+
+// Options configures a Runner.
+type Options struct {
+	// HopSize is the number of samples per analysis frame, as passed to
+	// tenvad.NewVad.
+	HopSize int
+	// Threshold is the VAD detection threshold, as passed to
+	// tenvad.NewVad.
+	Threshold float32
+	// Workers is the number of files processed concurrently. Defaults to
+	// runtime.NumCPU() if <= 0.
+	Workers int
+}
+
+// Segment is one contiguous run of speech-flagged frames.
+type Segment struct {
+	StartSample int64
+	EndSample   int64
+	StartMs     int64
+	EndMs       int64
+}
+
+// FileResult is the outcome of running the VAD over a single input file.
+type FileResult struct {
+	Path               string
+	SampleRate         int
+	FrameProbabilities []float32
+	FrameFlags         []bool
+	Segments           []Segment
+	SpeechRatio        float64
+	Err                error
+}
+
+// Runner processes many audio files in parallel using a pool of Vad
+// instances, one per worker goroutine.
+type Runner struct {
+	opts Options
+}
+
+// NewRunner creates a Runner from opts.
+func NewRunner(opts Options) *Runner {
+	if opts.Workers <= 0 {
+		opts.Workers = runtime.NumCPU()
+	}
+	return &Runner{opts: opts}
+}
+
+// Run reads file paths from inputs and writes one FileResult per path to
+// results, using up to Options.Workers goroutines. Run closes results and
+// returns once inputs is drained or ctx is canceled; canceling ctx also
+// abandons a result a worker is currently blocked sending, so a caller
+// that cancels ctx and stops draining results does not wedge Run.
+func (r *Runner) Run(ctx context.Context, inputs <-chan string, results chan<- FileResult) {
+	var wg sync.WaitGroup
+	for i := 0; i < r.opts.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case path, ok := <-inputs:
+					if !ok {
+						return
+					}
+					select {
+					case results <- r.processFile(path):
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(results)
+}
+
+func (r *Runner) processFile(path string) FileResult {
+	samples, sampleRate, err := audioio.DecodeFile(path)
+	if err != nil {
+		return FileResult{Path: path, Err: err}
+	}
+
+	vad, err := tenvad.NewVad(r.opts.HopSize, r.opts.Threshold)
+	if err != nil {
+		return FileResult{Path: path, Err: err}
+	}
+	defer vad.Close()
+
+	numFrames := len(samples) / r.opts.HopSize
+	probabilities := make([]float32, 0, numFrames)
+	flags := make([]bool, 0, numFrames)
+	var speechFrames int
+
+	for i := 0; i < numFrames; i++ {
+		frame := samples[i*r.opts.HopSize : (i+1)*r.opts.HopSize]
+		probability, isSpeech, err := vad.Process(frame)
+		if err != nil {
+			return FileResult{Path: path, SampleRate: sampleRate, Err: err}
+		}
+		probabilities = append(probabilities, probability)
+		flags = append(flags, isSpeech)
+		if isSpeech {
+			speechFrames++
+		}
+	}
+
+	var speechRatio float64
+	if numFrames > 0 {
+		speechRatio = float64(speechFrames) / float64(numFrames)
+	}
+
+	return FileResult{
+		Path:               path,
+		SampleRate:         sampleRate,
+		FrameProbabilities: probabilities,
+		FrameFlags:         flags,
+		Segments:           segmentsFromFlags(flags, r.opts.HopSize, sampleRate),
+		SpeechRatio:        speechRatio,
+	}
+}
+
+// segmentsFromFlags collapses consecutive speech-flagged frames into
+// Segments.
+func segmentsFromFlags(flags []bool, hopSize, sampleRate int) []Segment {
+	var segments []Segment
+	inSpeech := false
+	var startFrame int
+
+	for i, speech := range flags {
+		switch {
+		case speech && !inSpeech:
+			inSpeech = true
+			startFrame = i
+		case !speech && inSpeech:
+			inSpeech = false
+			segments = append(segments, newSegment(startFrame, i, hopSize, sampleRate))
+		}
+	}
+	if inSpeech {
+		segments = append(segments, newSegment(startFrame, len(flags), hopSize, sampleRate))
+	}
+	return segments
+}
+
+func newSegment(startFrame, endFrame, hopSize, sampleRate int) Segment {
+	startSample := int64(startFrame) * int64(hopSize)
+	endSample := int64(endFrame) * int64(hopSize)
+	return Segment{
+		StartSample: startSample,
+		EndSample:   endSample,
+		StartMs:     startSample * 1000 / int64(sampleRate),
+		EndMs:       endSample * 1000 / int64(sampleRate),
+	}
+}
@@ -0,0 +1,79 @@
+//
+//  Copyright © 2025 Agora
+//  This file is part of TEN Framework, an open source project.
+//  Licensed under the Apache License, Version 2.0, with certain conditions.
+//  Refer to the "LICENSE" file in the root directory for more information.
+//
+package batch
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// jsonFileResult mirrors FileResult for JSON encoding, since error values
+// don't round-trip through encoding/json on their own.
+type jsonFileResult struct {
+	Path               string    `json:"path"`
+	SampleRate         int       `json:"sample_rate"`
+	FrameProbabilities []float32 `json:"frame_probabilities"`
+	FrameFlags         []bool    `json:"frame_flags"`
+	Segments           []Segment `json:"segments"`
+	SpeechRatio        float64   `json:"speech_ratio"`
+	Error              string    `json:"error,omitempty"`
+}
+
+// WriteJSON writes fr to w as a single JSON object.
+func (fr FileResult) WriteJSON(w io.Writer) error {
+	out := jsonFileResult{
+		Path:               fr.Path,
+		SampleRate:         fr.SampleRate,
+		FrameProbabilities: fr.FrameProbabilities,
+		FrameFlags:         fr.FrameFlags,
+		Segments:           fr.Segments,
+		SpeechRatio:        fr.SpeechRatio,
+	}
+	if fr.Err != nil {
+		out.Error = fr.Err.Error()
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+// WriteCSV writes one row per frame, with a frame_index, probability, and
+// flag column.
+func (fr FileResult) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"frame_index", "probability", "is_speech"}); err != nil {
+		return err
+	}
+	for i, p := range fr.FrameProbabilities {
+		flag := "0"
+		if i < len(fr.FrameFlags) && fr.FrameFlags[i] {
+			flag = "1"
+		}
+		row := []string{strconv.Itoa(i), strconv.FormatFloat(float64(p), 'f', 6, 32), flag}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteLabelTrack writes fr's segments as an Audacity-compatible label
+// track: one "start\tend\tlabel" row per segment, in seconds.
+func (fr FileResult) WriteLabelTrack(w io.Writer) error {
+	for _, seg := range fr.Segments {
+		startSec := float64(seg.StartMs) / 1000.0
+		endSec := float64(seg.EndMs) / 1000.0
+		if _, err := fmt.Fprintf(w, "%.6f\t%.6f\tspeech\n", startSec, endSec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+//
+//  Copyright © 2025 Agora
+//  This file is part of TEN Framework, an open source project.
+//  Licensed under the Apache License, Version 2.0, with certain conditions.
+//  Refer to the "LICENSE" file in the root directory for more information.
+//
+
+// Command mic-vad-demo runs the VAD against live microphone input. Build
+// it with `go build -tags portaudio` to link the PortAudio backend;
+// without the tag it will fail to open a stream.
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"tenvad"
+	"tenvad/mic"
+)
+
+func main() {
+	const (
+		sampleRate = 16000
+		hopSize    = 256
+		threshold  = float32(0.5)
+	)
+
+	stream, err := mic.OpenDefaultInput(sampleRate, hopSize)
+	if err != nil {
+		log.Fatalf("Failed to open default input: %v", err)
+	}
+	defer func() {
+		if err := stream.Close(); err != nil {
+			log.Printf("Error closing mic stream: %v", err)
+		}
+	}()
+
+	vadInstance, err := tenvad.NewVad(hopSize, threshold)
+	if err != nil {
+		log.Fatalf("Failed to create VAD instance: %v", err)
+	}
+	defer vadInstance.Close()
+
+	fmt.Println("Listening on default input... press Ctrl+C to stop.")
+	for frame := range stream.Frames() {
+		probability, isSpeech, err := vadInstance.Process(frame)
+		if err != nil {
+			log.Printf("Error processing frame: %v", err)
+			continue
+		}
+		speechFlag := 0
+		if isSpeech {
+			speechFlag = 1
+		}
+		fmt.Printf("%.6f, %d\n", probability, speechFlag)
+	}
+}
@@ -0,0 +1,117 @@
+//
+//  Copyright © 2025 Agora
+//  This file is part of TEN Framework, an open source project.
+//  Licensed under the Apache License, Version 2.0, with certain conditions.
+//  Refer to the "LICENSE" file in the root directory for more information.
+//
+
+// Command tenvad-batch runs the VAD over every audio file in a directory
+// and writes one Audacity-compatible label file per input, turning tenvad
+// into an offline dataset-labeling tool.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"tenvad/batch"
+)
+
+var supportedExts = map[string]bool{
+	".wav":  true,
+	".flac": true,
+	".mp3":  true,
+	".opus": true,
+}
+
+func main() {
+	dir := flag.String("dir", ".", "directory to glob audio files from")
+	hopSize := flag.Int("hop-size", 256, "samples per analysis frame")
+	threshold := flag.Float64("threshold", 0.5, "VAD detection threshold")
+	workers := flag.Int("workers", 0, "number of files to process concurrently (default: number of CPUs)")
+	format := flag.String("format", "label", "output format: label, json, or csv")
+	flag.Parse()
+
+	inputs, err := globAudioFiles(*dir)
+	if err != nil {
+		log.Fatalf("Failed to glob '%s': %v", *dir, err)
+	}
+	if len(inputs) == 0 {
+		log.Fatalf("No audio files found under '%s'", *dir)
+	}
+	fmt.Printf("Found %d audio file(s) under '%s'\n", len(inputs), *dir)
+
+	runner := batch.NewRunner(batch.Options{
+		HopSize:   *hopSize,
+		Threshold: float32(*threshold),
+		Workers:   *workers,
+	})
+
+	inputCh := make(chan string, len(inputs))
+	for _, path := range inputs {
+		inputCh <- path
+	}
+	close(inputCh)
+
+	results := make(chan batch.FileResult)
+	go runner.Run(context.Background(), inputCh, results)
+
+	for res := range results {
+		if res.Err != nil {
+			log.Printf("Error processing '%s': %v", res.Path, res.Err)
+			continue
+		}
+		if err := writeResult(res, *format); err != nil {
+			log.Printf("Error writing output for '%s': %v", res.Path, err)
+			continue
+		}
+		fmt.Printf("%s: %d frame(s), %d segment(s), %.1f%% speech\n",
+			res.Path, len(res.FrameProbabilities), len(res.Segments), res.SpeechRatio*100)
+	}
+}
+
+func globAudioFiles(dir string) ([]string, error) {
+	var inputs []string
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if supportedExts[strings.ToLower(filepath.Ext(path))] {
+			inputs = append(inputs, path)
+		}
+		return nil
+	})
+	return inputs, err
+}
+
+func outputPath(inputPath, format string) string {
+	ext := map[string]string{"label": ".txt", "json": ".json", "csv": ".csv"}[format]
+	return strings.TrimSuffix(inputPath, filepath.Ext(inputPath)) + ".tenvad" + ext
+}
+
+func writeResult(res batch.FileResult, format string) error {
+	f, err := os.Create(outputPath(res.Path, format))
+	if err != nil {
+		return fmt.Errorf("could not create output file: %w", err)
+	}
+	defer f.Close()
+
+	switch format {
+	case "json":
+		return res.WriteJSON(f)
+	case "csv":
+		return res.WriteCSV(f)
+	case "label":
+		return res.WriteLabelTrack(f)
+	default:
+		return fmt.Errorf("unknown format %q (want label, json, or csv)", format)
+	}
+}
@@ -0,0 +1,81 @@
+//
+//  Copyright © 2025 Agora
+//  This file is part of TEN Framework, an open source project.
+//  Licensed under the Apache License, Version 2.0, with certain conditions.
+//  Refer to the "LICENSE" file in the root directory for more information.
+//
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"tenvad"
+	"tenvad/audioio"
+)
+
+func main() {
+	fmt.Println("Starting VAD demo with WAV file processing (using audioio)...")
+
+	wavFilePath := "../../s0724-s0730.wav" // Placeholder: You need to provide a "input.wav" file in the same directory or specify a full path.
+
+	// VAD Parameters
+	hopSize := 256            // Frame size in samples
+	threshold := float32(0.5) // VAD detection threshold
+
+	// 1. Load audio samples from file. audioio.DecodeFile downmixes to
+	// mono and resamples to a rate the VAD supports.
+	audioSamples, sampleRate, err := audioio.DecodeFile(wavFilePath)
+	if err != nil {
+		log.Fatalf("Failed to load audio file '%s': %v", wavFilePath, err)
+	}
+	if len(audioSamples) == 0 {
+		log.Fatalf("No audio samples loaded from file '%s'.", wavFilePath)
+	}
+	fmt.Printf("Loaded %d samples at %d Hz from '%s'.\n", len(audioSamples), sampleRate, wavFilePath)
+
+	// 2. Initialize VAD
+	vadInstance, err := tenvad.NewVad(hopSize, threshold) // hopSize is in samples
+	if err != nil {
+		log.Fatalf("Failed to create VAD instance: %v", err)
+	}
+	defer func() {
+		fmt.Println("Closing VAD instance...")
+		if err := vadInstance.Close(); err != nil {
+			log.Printf("Error closing VAD instance: %v", err)
+		}
+		fmt.Println("VAD instance closed.")
+	}()
+
+	fmt.Printf("VAD instance created successfully. Hop Size (Frame Size): %d samples, Threshold: %.2f\n",
+		vadInstance.FrameSize(), threshold)
+
+	// 3. Process audio frames from the loaded file
+	numFrames := len(audioSamples) / hopSize
+	fmt.Printf("Total samples: %d, Hop size: %d, Number of full frames to process: %d\n", len(audioSamples), hopSize, numFrames)
+
+	for i := 0; i < numFrames; i++ {
+		start := i * hopSize
+		end := start + hopSize
+		frame := audioSamples[start:end]
+
+		probability, isSpeech, err := vadInstance.Process(frame)
+		if err != nil {
+			log.Printf("Error processing frame %d: %v", i, err)
+			continue
+		}
+
+		speechFlag := 0
+		if isSpeech {
+			speechFlag = 1
+		}
+		fmt.Printf("[%d] %.6f, %d\n", i, probability, speechFlag)
+	}
+
+	remainingSamples := len(audioSamples) % hopSize
+	if remainingSamples > 0 {
+		fmt.Printf("Note: %d remaining samples at the end of the file were not processed as they don't form a full frame of size %d.\n", remainingSamples, hopSize)
+	}
+
+	fmt.Println("VAD demo with WAV file finished.")
+}
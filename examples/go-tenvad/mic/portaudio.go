@@ -0,0 +1,73 @@
+//
+//  Copyright © 2025 Agora
+//  This file is part of TEN Framework, an open source project.
+//  Licensed under the Apache License, Version 2.0, with certain conditions.
+//  Refer to the "LICENSE" file in the root directory for more information.
+//
+//go:build portaudio
+
+package mic
+
+import (
+	"fmt"
+
+	"github.com/gordonklaus/portaudio"
+)
+
+// OpenDefaultInput opens the system's default input device and returns a
+// Stream that yields hopSize-length int16 frames at sampleRate. The device
+// must natively support sampleRate; OpenDefaultInput does not resample.
+func OpenDefaultInput(sampleRate int, hopSize int) (*Stream, error) {
+	if err := portaudio.Initialize(); err != nil {
+		return nil, fmt.Errorf("mic: portaudio init failed: %w", err)
+	}
+
+	in := make([]int16, hopSize)
+	paStream, err := portaudio.OpenDefaultStream(1, 0, float64(sampleRate), hopSize, in)
+	if err != nil {
+		portaudio.Terminate()
+		return nil, fmt.Errorf("mic: open default input failed: %w", err)
+	}
+
+	if err := paStream.Start(); err != nil {
+		paStream.Close()
+		portaudio.Terminate()
+		return nil, fmt.Errorf("mic: start input stream failed: %w", err)
+	}
+
+	frames := make(chan []int16, 4)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(frames)
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			if err := paStream.Read(); err != nil {
+				return
+			}
+			frame := make([]int16, hopSize)
+			copy(frame, in)
+			select {
+			case frames <- frame:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	closeFn := func() error {
+		close(done)
+		err := paStream.Stop()
+		if cerr := paStream.Close(); err == nil {
+			err = cerr
+		}
+		portaudio.Terminate()
+		return err
+	}
+
+	return &Stream{frames: frames, closeFn: closeFn}, nil
+}
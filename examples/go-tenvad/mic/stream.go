@@ -0,0 +1,30 @@
+//
+//  Copyright © 2025 Agora
+//  This file is part of TEN Framework, an open source project.
+//  Licensed under the Apache License, Version 2.0, with certain conditions.
+//  Refer to the "LICENSE" file in the root directory for more information.
+//
+
+// Package mic provides a real-time capture pipeline that feeds
+// microphone audio to tenvad.Vad. The PortAudio backend is gated behind
+// the "portaudio" build tag so that a plain `go build ./...` of this
+// module keeps working for callers who don't need live capture.
+package mic
+
+// Stream yields fixed-size int16 frames captured from an input device.
+type Stream struct {
+	frames  chan []int16
+	closeFn func() error
+}
+
+// Frames returns the channel of captured audio frames. Each frame is
+// exactly the hopSize passed to OpenDefaultInput. The channel is closed
+// when the stream is closed or the input device errors out.
+func (s *Stream) Frames() <-chan []int16 {
+	return s.frames
+}
+
+// Close stops capture and releases the underlying input device.
+func (s *Stream) Close() error {
+	return s.closeFn()
+}
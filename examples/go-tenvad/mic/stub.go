@@ -0,0 +1,21 @@
+//
+//  Copyright © 2025 Agora
+//  This file is part of TEN Framework, an open source project.
+//  Licensed under the Apache License, Version 2.0, with certain conditions.
+//  Refer to the "LICENSE" file in the root directory for more information.
+//
+//go:build !portaudio
+
+package mic
+
+import "errors"
+
+// ErrNoPortAudio is returned by OpenDefaultInput when this module was built
+// without the "portaudio" build tag.
+var ErrNoPortAudio = errors.New("mic: built without portaudio support (rebuild with -tags portaudio)")
+
+// OpenDefaultInput always fails in this build; rebuild with -tags portaudio
+// to enable live microphone capture.
+func OpenDefaultInput(sampleRate int, hopSize int) (*Stream, error) {
+	return nil, ErrNoPortAudio
+}
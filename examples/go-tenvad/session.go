@@ -0,0 +1,309 @@
+//
+//  Copyright © 2025 Agora
+//  This file is part of TEN Framework, an open source project.
+//  Licensed under the Apache License, Version 2.0, with certain conditions.
+//  Refer to the "LICENSE" file in the root directory for more information.
+//
+package tenvad
+
+import "fmt"
+
+// VadTransition is implemented by the event types emitted from
+// VadSession.ProcessChunk: SpeechStart, SpeechEnd, and Speaking.
+type VadTransition interface {
+	isVadTransition()
+}
+
+// SpeechStart is emitted the moment a speech segment is confirmed, i.e. once
+// MinSpeechMs worth of consecutive speech-flagged frames have been observed.
+// TimestampMs is the estimated start of the speech, measured from the first
+// sample ever passed to ProcessChunk.
+type SpeechStart struct {
+	TimestampMs int64
+}
+
+// SpeechEnd is emitted once a confirmed speech segment ends, i.e. once
+// RedemptionMs worth of consecutive silent frames have been observed after
+// speech. Audio contains the full utterance, including any configured
+// pre/post padding.
+type SpeechEnd struct {
+	StartMs int64
+	EndMs   int64
+	Audio   []int16
+}
+
+// Speaking is emitted once per ProcessChunk call while a speech segment is
+// in progress, summarizing the average speech probability of the frames
+// processed during that call.
+type Speaking struct {
+	ProbabilityAvg float32
+}
+
+func (SpeechStart) isVadTransition() {}
+func (SpeechEnd) isVadTransition()   {}
+func (Speaking) isVadTransition()    {}
+
+// SessionConfig configures a VadSession.
+type SessionConfig struct {
+	// HopSize is the number of samples per analysis frame, as passed to
+	// NewVad (e.g. 256).
+	HopSize int
+	// SampleRate is the sample rate of the audio passed to ProcessChunk, in
+	// Hz (8000, 16000, 32000, or 48000).
+	SampleRate int
+	// Threshold is the VAD detection threshold, as passed to NewVad.
+	Threshold float32
+
+	// MinSpeechMs is the minimum run of consecutive speech-flagged frames
+	// required before a SpeechStart is emitted. This suppresses spurious
+	// one-frame blips from being reported as speech.
+	MinSpeechMs int
+	// RedemptionMs is the minimum run of consecutive silence-flagged frames
+	// required, once in speech, before a SpeechEnd is emitted. This lets
+	// short pauses within an utterance (a breath, a stop consonant) pass
+	// without splitting the segment.
+	RedemptionMs int
+	// PrePaddingMs is how much audio preceding the detected start of speech
+	// to include in the returned segment.
+	PrePaddingMs int
+	// PostPaddingMs is how much audio following the detected end of speech
+	// to include in the returned segment. It cannot exceed RedemptionMs,
+	// since audio beyond the redemption window is never buffered.
+	PostPaddingMs int
+}
+
+// VadSession turns a continuous stream of audio into speech-segment events.
+// It wraps a Vad instance, buffering partial frames and applying hysteresis
+// (MinSpeechMs / RedemptionMs) so that callers don't have to reimplement
+// frame bookkeeping and debouncing on top of the raw per-frame Process API.
+//
+// A VadSession is not safe for concurrent use.
+type VadSession struct {
+	vad frameClassifier
+	cfg SessionConfig
+
+	hopDurationMs    float64
+	minSpeechFrames  int
+	redemptionFrames int
+	prePadSamples    int
+	postPadSamples   int
+
+	pending []int16 // leftover samples not yet forming a full hop
+
+	preRoll []int16 // rolling buffer of the last prePadSamples samples seen
+
+	speechRun   int     // consecutive speech-flagged frames while not yet in speech
+	silenceRun  int     // consecutive silence-flagged frames while in speech
+	tentative   []int16 // audio accumulated during an unconfirmed speech run
+	inSpeech    bool
+	speechAudio []int16 // confirmed in-progress utterance audio
+
+	processedSamples int64
+	speechStartMs    int64
+	speechEndMs      int64
+}
+
+// frameClassifier is the subset of *Vad that VadSession relies on. It
+// exists so the hysteresis logic in this file can be driven by a fake in
+// tests, without linking the real ten_vad C library.
+type frameClassifier interface {
+	Process(frame []int16) (float32, bool, error)
+	Close() error
+}
+
+// NewVadSession creates a VadSession, constructing its own underlying Vad
+// instance from cfg.HopSize and cfg.Threshold.
+func NewVadSession(cfg SessionConfig) (*VadSession, error) {
+	if cfg.SampleRate <= 0 {
+		return nil, fmt.Errorf("tenvad: invalid sample rate %d", cfg.SampleRate)
+	}
+	vad, err := NewVad(cfg.HopSize, cfg.Threshold)
+	if err != nil {
+		return nil, err
+	}
+	return newVadSession(cfg, vad)
+}
+
+// newVadSession builds a VadSession around an already-constructed
+// frameClassifier, factoring out the derived-field setup shared by
+// NewVadSession and tests.
+func newVadSession(cfg SessionConfig, vad frameClassifier) (*VadSession, error) {
+	s := &VadSession{
+		vad: vad,
+		cfg: cfg,
+	}
+	s.hopDurationMs = float64(cfg.HopSize) * 1000.0 / float64(cfg.SampleRate)
+	s.minSpeechFrames = framesForMs(cfg.MinSpeechMs, s.hopDurationMs)
+	s.redemptionFrames = framesForMs(cfg.RedemptionMs, s.hopDurationMs)
+	s.prePadSamples = cfg.PrePaddingMs * cfg.SampleRate / 1000
+	s.postPadSamples = cfg.PostPaddingMs * cfg.SampleRate / 1000
+	return s, nil
+}
+
+func framesForMs(ms int, hopDurationMs float64) int {
+	if ms <= 0 || hopDurationMs <= 0 {
+		return 1
+	}
+	frames := int((float64(ms) + hopDurationMs - 1) / hopDurationMs)
+	if frames < 1 {
+		frames = 1
+	}
+	return frames
+}
+
+// ProcessChunk accepts samples of any length, buffering any partial frame
+// until enough audio has accumulated to run the VAD again. It returns the
+// transitions (if any) produced by the frames completed during this call.
+func (s *VadSession) ProcessChunk(samples []int16) ([]VadTransition, error) {
+	hopSize := s.cfg.HopSize
+	s.pending = append(s.pending, samples...)
+
+	var transitions []VadTransition
+	// speakingProbSum/speakingProbCount accumulate probabilities for the
+	// Speaking event below; they are reset whenever a SpeechEnd closes a
+	// segment mid-call, so a SpeechStart confirmed later in the same call
+	// doesn't average in probabilities left over from the prior segment.
+	var speakingProbSum float32
+	var speakingProbCount int
+
+	for len(s.pending) >= hopSize {
+		frame := s.pending[:hopSize]
+		s.pending = s.pending[hopSize:]
+
+		probability, isSpeech, err := s.vad.Process(frame)
+		if err != nil {
+			return transitions, err
+		}
+		s.processedSamples += int64(hopSize)
+		nowMs := s.processedSamples * 1000 / int64(s.cfg.SampleRate)
+
+		s.pushPreRoll(frame)
+
+		if !s.inSpeech {
+			if isSpeech {
+				s.speechRun++
+				s.tentative = append(s.tentative, frame...)
+				if s.speechRun >= s.minSpeechFrames {
+					s.speechStartMs = nowMs - int64(float64(s.speechRun)*s.hopDurationMs)
+					s.speechAudio = append(append([]int16{}, s.preRollBefore(s.tentative)...), s.tentative...)
+					s.tentative = nil
+					s.inSpeech = true
+					s.silenceRun = 0
+					transitions = append(transitions, SpeechStart{TimestampMs: s.speechStartMs})
+				}
+			} else {
+				s.speechRun = 0
+				s.tentative = nil
+			}
+		} else {
+			s.speechAudio = append(s.speechAudio, frame...)
+			speakingProbSum += probability
+			speakingProbCount++
+
+			if isSpeech {
+				s.silenceRun = 0
+			} else {
+				s.silenceRun++
+				if s.silenceRun >= s.redemptionFrames {
+					s.speechEndMs = nowMs - int64(float64(s.silenceRun)*s.hopDurationMs)
+					transitions = append(transitions, SpeechEnd{
+						StartMs: s.speechStartMs,
+						EndMs:   s.speechEndMs,
+						Audio:   s.trimTrailingSilence(),
+					})
+					s.inSpeech = false
+					s.speechRun = 0
+					s.silenceRun = 0
+					s.speechAudio = nil
+					speakingProbSum = 0
+					speakingProbCount = 0
+				}
+			}
+		}
+	}
+
+	if s.inSpeech && speakingProbCount > 0 {
+		transitions = append(transitions, Speaking{ProbabilityAvg: speakingProbSum / float32(speakingProbCount)})
+	}
+	return transitions, nil
+}
+
+// pushPreRoll maintains a rolling window of the last prePadSamples samples
+// seen, so that when a speech start is confirmed we can reach back for
+// pre-padding even though the frames predating the tentative run have
+// already been handed back to the caller.
+func (s *VadSession) pushPreRoll(frame []int16) {
+	if s.prePadSamples <= 0 {
+		return
+	}
+	s.preRoll = append(s.preRoll, frame...)
+	if excess := len(s.preRoll) - s.prePadSamples; excess > 0 {
+		s.preRoll = s.preRoll[excess:]
+	}
+}
+
+// preRollBefore returns up to prePadSamples samples that directly precede
+// tentative in the stream.
+func (s *VadSession) preRollBefore(tentative []int16) []int16 {
+	if s.prePadSamples <= 0 || len(s.preRoll) <= len(tentative) {
+		return nil
+	}
+	return s.preRoll[:len(s.preRoll)-len(tentative)]
+}
+
+// trimTrailingSilence drops redemption-window silence beyond PostPaddingMs
+// from the end of speechAudio before handing the segment back.
+func (s *VadSession) trimTrailingSilence() []int16 {
+	redemptionSamples := s.silenceRun * s.cfg.HopSize
+	drop := redemptionSamples - s.postPadSamples
+	if drop <= 0 || drop > len(s.speechAudio) {
+		return s.speechAudio
+	}
+	return s.speechAudio[:len(s.speechAudio)-drop]
+}
+
+// Reset clears all buffered audio and hysteresis state without destroying
+// the underlying C instance, so the session can be reused for a new stream.
+func (s *VadSession) Reset() {
+	s.pending = nil
+	s.preRoll = nil
+	s.tentative = nil
+	s.speechAudio = nil
+	s.speechRun = 0
+	s.silenceRun = 0
+	s.inSpeech = false
+	s.processedSamples = 0
+	s.speechStartMs = 0
+	s.speechEndMs = 0
+}
+
+// Close releases the underlying Vad instance.
+func (s *VadSession) Close() error {
+	return s.vad.Close()
+}
+
+// CurrentSpeechAudio returns the audio accumulated so far for the
+// in-progress utterance, or nil if no speech segment is currently open.
+func (s *VadSession) CurrentSpeechAudio() []int16 {
+	if !s.inSpeech {
+		return nil
+	}
+	return s.speechAudio
+}
+
+// ProcessedSamples returns the total number of samples processed so far.
+func (s *VadSession) ProcessedSamples() int64 {
+	return s.processedSamples
+}
+
+// SpeechStartMs returns the start timestamp, in milliseconds, of the most
+// recently confirmed speech segment.
+func (s *VadSession) SpeechStartMs() int64 {
+	return s.speechStartMs
+}
+
+// SpeechEndMs returns the end timestamp, in milliseconds, of the most
+// recently closed speech segment.
+func (s *VadSession) SpeechEndMs() int64 {
+	return s.speechEndMs
+}
@@ -0,0 +1,216 @@
+//
+//  Copyright © 2025 Agora
+//  This file is part of TEN Framework, an open source project.
+//  Licensed under the Apache License, Version 2.0, with certain conditions.
+//  Refer to the "LICENSE" file in the root directory for more information.
+//
+package tenvad
+
+import "testing"
+
+// scriptedClassifier is a frameClassifier that replays a fixed sequence of
+// speech/silence flags, one per Process call, standing in for the real
+// ten_vad C library so the hysteresis logic in VadSession can be tested
+// without it.
+type scriptedClassifier struct {
+	flags []bool
+	next  int
+}
+
+func (c *scriptedClassifier) Process(frame []int16) (float32, bool, error) {
+	speech := c.next < len(c.flags) && c.flags[c.next]
+	c.next++
+	var probability float32
+	if speech {
+		probability = 1.0
+	}
+	return probability, speech, nil
+}
+
+func (c *scriptedClassifier) Close() error { return nil }
+
+// newTestSession builds a VadSession whose frames are classified by flags
+// (one bool per hop) instead of a real Vad instance.
+func newTestSession(t *testing.T, cfg SessionConfig, flags []bool) *VadSession {
+	t.Helper()
+	if cfg.HopSize == 0 {
+		cfg.HopSize = 160
+	}
+	if cfg.SampleRate == 0 {
+		cfg.SampleRate = 16000
+	}
+	s, err := newVadSession(cfg, &scriptedClassifier{flags: flags})
+	if err != nil {
+		t.Fatalf("newVadSession: %v", err)
+	}
+	return s
+}
+
+// feed pushes one hop-sized chunk of silent samples through s per entry in
+// flags, returning the transitions seen across the whole sequence. The
+// scriptedClassifier ignores the actual sample values, so the content
+// doesn't matter, only the count of hops fed.
+func feed(t *testing.T, s *VadSession, n int) []VadTransition {
+	t.Helper()
+	var all []VadTransition
+	frame := make([]int16, s.cfg.HopSize)
+	for i := 0; i < n; i++ {
+		transitions, err := s.ProcessChunk(frame)
+		if err != nil {
+			t.Fatalf("ProcessChunk: %v", err)
+		}
+		all = append(all, transitions...)
+	}
+	return all
+}
+
+func countSpeechStarts(transitions []VadTransition) int {
+	n := 0
+	for _, t := range transitions {
+		if _, ok := t.(SpeechStart); ok {
+			n++
+		}
+	}
+	return n
+}
+
+func countSpeechEnds(transitions []VadTransition) int {
+	n := 0
+	for _, t := range transitions {
+		if _, ok := t.(SpeechEnd); ok {
+			n++
+		}
+	}
+	return n
+}
+
+func TestVadSessionSuppressesShortSpeechBlip(t *testing.T) {
+	// MinSpeechMs worth of 3 hops; a single speech-flagged frame is well
+	// under that and should never confirm a SpeechStart.
+	cfg := SessionConfig{MinSpeechMs: 60, RedemptionMs: 60}
+	flags := []bool{false, true, false, false, false}
+	s := newTestSession(t, cfg, flags)
+
+	var got []VadTransition
+	for range flags {
+		transitions := feed(t, s, 1)
+		got = append(got, transitions...)
+	}
+
+	if n := countSpeechStarts(got); n != 0 {
+		t.Fatalf("SpeechStart count = %d, want 0 for a blip shorter than MinSpeechMs", n)
+	}
+}
+
+func TestVadSessionConfirmsSpeechAfterMinSpeechMs(t *testing.T) {
+	// hopDurationMs = 10ms at 16kHz/160 samples, so MinSpeechMs=30 needs 3
+	// consecutive speech frames.
+	cfg := SessionConfig{MinSpeechMs: 30, RedemptionMs: 30}
+	flags := []bool{true, true, true, true}
+	s := newTestSession(t, cfg, flags)
+
+	got := feed(t, s, len(flags))
+
+	if n := countSpeechStarts(got); n != 1 {
+		t.Fatalf("SpeechStart count = %d, want 1", n)
+	}
+}
+
+func TestVadSessionRedemptionSurvivesShortPause(t *testing.T) {
+	// RedemptionMs=50 needs 5 consecutive silent frames to end a segment;
+	// a 2-frame pause in the middle of speech should not emit SpeechEnd.
+	cfg := SessionConfig{MinSpeechMs: 20, RedemptionMs: 50}
+	flags := []bool{true, true, false, false, true, true, true}
+	s := newTestSession(t, cfg, flags)
+
+	got := feed(t, s, len(flags))
+
+	if n := countSpeechEnds(got); n != 0 {
+		t.Fatalf("SpeechEnd count = %d, want 0, pause was shorter than RedemptionMs", n)
+	}
+	if !s.inSpeech {
+		t.Fatalf("session should still be in speech after a short pause")
+	}
+}
+
+func TestVadSessionEndsAfterRedemptionMs(t *testing.T) {
+	cfg := SessionConfig{MinSpeechMs: 20, RedemptionMs: 30}
+	flags := []bool{true, true, false, false, false}
+	s := newTestSession(t, cfg, flags)
+
+	got := feed(t, s, len(flags))
+
+	if n := countSpeechEnds(got); n != 1 {
+		t.Fatalf("SpeechEnd count = %d, want 1", n)
+	}
+	if s.inSpeech {
+		t.Fatalf("session should no longer be in speech after RedemptionMs of silence")
+	}
+}
+
+func TestVadSessionPrePaddingIncludesAudioBeforeSpeechStart(t *testing.T) {
+	// MinSpeechMs=10 confirms on the very first speech-flagged frame, so
+	// only 1 hop of the pre-roll window is "spent" on the confirmed frame
+	// itself; the other hop of PrePaddingMs=20 (2 hops) should be pulled in
+	// from the silence immediately preceding it.
+	cfg := SessionConfig{HopSize: 160, SampleRate: 16000, MinSpeechMs: 10, RedemptionMs: 1000, PrePaddingMs: 20}
+	flags := []bool{false, false, true}
+	s := newTestSession(t, cfg, flags)
+
+	_ = feed(t, s, len(flags))
+
+	wantSamples := 2 * cfg.HopSize
+	if got := len(s.CurrentSpeechAudio()); got != wantSamples {
+		t.Fatalf("CurrentSpeechAudio length = %d, want %d", got, wantSamples)
+	}
+}
+
+func TestVadSessionPostPaddingTrimsTrailingSilence(t *testing.T) {
+	cfg := SessionConfig{HopSize: 160, SampleRate: 16000, MinSpeechMs: 10, RedemptionMs: 50, PostPaddingMs: 10}
+	// 1 speech frame, then 5 silent frames (RedemptionMs=50 -> 5 hops) to
+	// trigger SpeechEnd; PostPaddingMs=10 keeps only 1 of those 5 silent
+	// hops in the returned segment.
+	flags := []bool{true, false, false, false, false, false}
+	s := newTestSession(t, cfg, flags)
+
+	got := feed(t, s, len(flags))
+
+	var end SpeechEnd
+	found := false
+	for _, tr := range got {
+		if e, ok := tr.(SpeechEnd); ok {
+			end = e
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected a SpeechEnd transition")
+	}
+
+	wantSamples := 2 * cfg.HopSize // 1 speech hop + 1 hop of post-padding
+	if got := len(end.Audio); got != wantSamples {
+		t.Fatalf("SpeechEnd.Audio length = %d, want %d", got, wantSamples)
+	}
+}
+
+func TestVadSessionReset(t *testing.T) {
+	cfg := SessionConfig{MinSpeechMs: 10, RedemptionMs: 1000}
+	s := newTestSession(t, cfg, []bool{true, true})
+
+	feed(t, s, 2)
+	if !s.inSpeech {
+		t.Fatalf("expected session to be in speech before Reset")
+	}
+
+	s.Reset()
+
+	if s.inSpeech {
+		t.Fatalf("Reset should clear in-progress speech state")
+	}
+	if s.ProcessedSamples() != 0 {
+		t.Fatalf("Reset should zero ProcessedSamples, got %d", s.ProcessedSamples())
+	}
+	if s.CurrentSpeechAudio() != nil {
+		t.Fatalf("Reset should clear buffered speech audio")
+	}
+}
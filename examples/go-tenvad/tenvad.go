@@ -4,7 +4,7 @@
 //  Licensed under the Apache License, Version 2.0, with certain conditions.
 //  Refer to the "LICENSE" file in the root directory for more information.
 //
-package main
+package tenvad
 
 /*
 #cgo CFLAGS: -I${SRCDIR}/../../include